@@ -4,8 +4,6 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	"golang.org/x/text/encoding/japanese"
-	"golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/transform"
 	"gorm.io/datatypes"
 	"os"
@@ -14,40 +12,60 @@ import (
 )
 
 const (
-	dateFormat = "20060102" // 日付フォーマット
-	timeFormat = "15:04:05" // 時刻フォーマット
+	dateFormat = "20060102" // 日付フォーマットのデフォルト値 (WithDateFormat で上書き可能)
+	timeFormat = "15:04:05" // 時刻フォーマットのデフォルト値 (WithTimeFormat で上書き可能)
 )
 
 // DataFrame はヘッダー情報と行データを保持する構造体
 type DataFrame struct {
 	Headers map[string]int
 	Records [][]string
+
+	dateFormat string
+	timeFormat string
+}
+
+// applyDialect は csvConfig の区切り文字・コメント・引用符などの設定を csv.Reader に反映します。
+// RowIterator と共通のロジックです。
+func applyDialect(reader *csv.Reader, config *csvConfig) {
+	if config.delimiter != 0 {
+		reader.Comma = config.delimiter
+	}
+	if config.comment != 0 {
+		reader.Comment = config.comment
+	}
+	reader.LazyQuotes = config.lazyQuotes
+	if config.fieldsPerRecord != nil {
+		reader.FieldsPerRecord = *config.fieldsPerRecord
+	}
 }
 
-// OpenCSV は指定されたエンコーディングのCSVファイルを開き、データを読み込みます
-func OpenCSV(filePath string, encoding string) (*DataFrame, error) {
+// OpenCSV はCSVファイルを開き、オプションで指定されたエンコーディングや区切り文字にしたがってデータを読み込みます。
+func OpenCSV(filePath string, opts ...Option) (*DataFrame, error) {
+	config := buildConfig(opts...)
+
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 	defer file.Close()
 
-	var decoder transform.Transformer
-	switch encoding {
-	case "utf-8":
-		decoder = unicode.BOMOverride(unicode.UTF8.NewDecoder()) // UTF-8 (BOM付き)対応
-	case "shift-jis", "shift_jis", "sjis":
-		decoder = unicode.BOMOverride(japanese.ShiftJIS.NewDecoder()) // Shift-JIS対応
-	default:
-		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	decoder, err := config.resolveDecoder()
+	if err != nil {
+		return nil, err
 	}
 
 	reader := csv.NewReader(transform.NewReader(file, decoder))
+	applyDialect(reader, config)
 
-	// ヘッダー取得
-	headers, err := reader.Read()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read headers: %w", err)
+	var headers []string
+	if config.headerless != nil {
+		headers = config.headerless
+	} else {
+		headers, err = reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read headers: %w", err)
+		}
 	}
 
 	// データ取得
@@ -62,8 +80,10 @@ func OpenCSV(filePath string, encoding string) (*DataFrame, error) {
 	}
 
 	return &DataFrame{
-		Headers: headerMap,
-		Records: records,
+		Headers:    headerMap,
+		Records:    records,
+		dateFormat: config.dateFormat,
+		timeFormat: config.timeFormat,
 	}, nil
 }
 
@@ -98,16 +118,24 @@ func (df *DataFrame) ToJSON() (string, error) {
 
 // getValue は指定されたカラムと行番号から値を取得します。
 func (df *DataFrame) getValue(rowIndex int, columnName string) (string, error) {
-	idx, exists := df.Headers[columnName]
-	if !exists {
-		return "", fmt.Errorf("column '%s' does not exist", columnName)
-	}
-
 	if rowIndex < 0 || rowIndex >= len(df.Records) {
 		return "", fmt.Errorf("row index %d out of range", rowIndex)
 	}
 
-	return df.Records[rowIndex][idx], nil
+	return getCell(df.Headers, df.Records[rowIndex], columnName)
+}
+
+// getCell はヘッダーと1行分のレコードから、指定されたカラムの値を取得します。
+// DataFrame と RowIterator の Row の両方から共有される基盤ロジックです。
+func getCell(headers map[string]int, record []string, columnName string) (string, error) {
+	idx, exists := headers[columnName]
+	if !exists {
+		return "", fmt.Errorf("column '%s' does not exist", columnName)
+	}
+	if idx >= len(record) {
+		return "", fmt.Errorf("column '%s' is missing from record", columnName)
+	}
+	return record[idx], nil
 }
 
 // GetString は指定されたカラムの値を文字列として取得します。
@@ -191,7 +219,7 @@ func (df *DataFrame) GetDate(rowIndex int, columnName string) (datatypes.Date, e
 	if err != nil {
 		return datatypes.Date{}, err
 	}
-	parsed, err := time.Parse(dateFormat, value)
+	parsed, err := time.Parse(df.dateFormat, value)
 	if err != nil {
 		return datatypes.Date{}, fmt.Errorf("failed to parse date in column '%s' at row %d: %w", columnName, rowIndex, err)
 	}
@@ -207,7 +235,7 @@ func (df *DataFrame) GetDatePtr(rowIndex int, columnName string) (*datatypes.Dat
 	if value == "" {
 		return nil, nil
 	}
-	parsed, err := time.Parse(dateFormat, value)
+	parsed, err := time.Parse(df.dateFormat, value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse date in column '%s' at row %d: %w", columnName, rowIndex, err)
 	}
@@ -221,7 +249,7 @@ func (df *DataFrame) GetTime(rowIndex int, columnName string) (datatypes.Time, e
 	if err != nil {
 		return datatypes.NewTime(0, 0, 0, 0), err
 	}
-	parsed, err := time.Parse(timeFormat, value)
+	parsed, err := time.Parse(df.timeFormat, value)
 	if err != nil {
 		return datatypes.NewTime(0, 0, 0, 0), fmt.Errorf("failed to parse time in column '%s' at row %d: %w", columnName, rowIndex, err)
 	}
@@ -237,7 +265,7 @@ func (df *DataFrame) GetTimePtr(rowIndex int, columnName string) (*datatypes.Tim
 	if value == "" {
 		return nil, nil
 	}
-	parsed, err := time.Parse(timeFormat, value)
+	parsed, err := time.Parse(df.timeFormat, value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse time in column '%s' at row %d: %w", columnName, rowIndex, err)
 	}
@@ -0,0 +1,55 @@
+package csvloader
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+	"reflect"
+)
+
+// BindToModel は DataFrame の各行を model と同じ型の構造体に変換します。フィールドの割り当ては
+// Unmarshal と同じ `csv`/`label`/`format` タグにもとづき、model 自体が持つ `gorm:"column:..."`
+// タグはそのまま保持されます。戻り値は `[]interface{}` (各要素は *model 型) のため、
+// db.Create や CreateInBatches に渡す前に InsertInto のように型付きスライスへ詰め替える必要があります。
+func (df *DataFrame) BindToModel(model interface{}) ([]interface{}, error) {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("model must be a struct or pointer to struct")
+	}
+
+	rows := make([]interface{}, len(df.Records))
+	for i := range df.Records {
+		ptr := reflect.New(t)
+		if err := df.unmarshalRowAt(i, ptr.Elem()); err != nil {
+			return nil, err
+		}
+		rows[i] = ptr.Interface()
+	}
+	return rows, nil
+}
+
+// InsertInto は DataFrame の全行を model の型にバインドし、db.CreateInBatches でテーブルへ
+// バルクインサートします。
+func (df *DataFrame) InsertInto(db *gorm.DB, model interface{}, batchSize int) error {
+	rows, err := df.BindToModel(model)
+	if err != nil {
+		return fmt.Errorf("failed to bind rows to model: %w", err)
+	}
+
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	slice := reflect.MakeSlice(reflect.SliceOf(reflect.PtrTo(t)), len(rows), len(rows))
+	for i, row := range rows {
+		slice.Index(i).Set(reflect.ValueOf(row))
+	}
+
+	if err := db.CreateInBatches(slice.Interface(), batchSize).Error; err != nil {
+		return fmt.Errorf("failed to insert rows: %w", err)
+	}
+	return nil
+}
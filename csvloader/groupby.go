@@ -0,0 +1,174 @@
+package csvloader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// groupKeySeparator はグループキーを作る際にカラム値を連結するセパレータです。
+// CSVの値に含まれる可能性が低い制御文字を用います。
+const groupKeySeparator = "\x1f"
+
+// GroupedFrame は DataFrame.GroupBy によって分割されたグループ群を保持します。
+type GroupedFrame struct {
+	source    *DataFrame
+	groupCols []string
+	order     []string
+	groups    map[string][]int
+}
+
+// GroupBy は指定されたカラムの値が一致する行をグループ化します。
+func (df *DataFrame) GroupBy(columns ...string) *GroupedFrame {
+	groups := make(map[string][]int)
+	order := make([]string, 0)
+	for i := range df.Records {
+		key := df.groupKey(i, columns)
+		if _, exists := groups[key]; !exists {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+	return &GroupedFrame{source: df, groupCols: columns, order: order, groups: groups}
+}
+
+// groupKey はグループ化対象のカラム値を連結し、グループを一意に識別するキーを作ります。
+func (df *DataFrame) groupKey(rowIndex int, columns []string) string {
+	values := make([]string, len(columns))
+	for i, col := range columns {
+		values[i], _ = df.getValue(rowIndex, col)
+	}
+	return strings.Join(values, groupKeySeparator)
+}
+
+// resultHeaders はグループ化カラムに続けて集計結果のカラム名を並べたヘッダーマップを返します。
+func (g *GroupedFrame) resultHeaders(aggCol string) map[string]int {
+	headerMap := make(map[string]int, len(g.groupCols)+1)
+	for i, col := range g.groupCols {
+		headerMap[col] = i
+	}
+	headerMap[aggCol] = len(g.groupCols)
+	return headerMap
+}
+
+// groupColValues は、あるグループに属する最初の行からグループ化カラムの値を取り出します。
+func (g *GroupedFrame) groupColValues(rows []int) []string {
+	values := make([]string, len(g.groupCols))
+	for i, col := range g.groupCols {
+		values[i], _ = g.source.getValue(rows[0], col)
+	}
+	return values
+}
+
+// Count はグループごとの行数を集計した *DataFrame を返します。
+func (g *GroupedFrame) Count() *DataFrame {
+	headerMap := g.resultHeaders("count")
+	records := make([][]string, 0, len(g.order))
+	for _, key := range g.order {
+		rows := g.groups[key]
+		record := append(g.groupColValues(rows), strconv.Itoa(len(rows)))
+		records = append(records, record)
+	}
+	return &DataFrame{Headers: headerMap, Records: records, dateFormat: g.source.dateFormat, timeFormat: g.source.timeFormat}
+}
+
+// Sum はグループごとに col の値を合計した *DataFrame を返します。空セルは集計から除外します。
+// 全セルが空のグループは合計 0 として扱います (合計は空集合に対しても well-defined なため)。
+func (g *GroupedFrame) Sum(col string) (*DataFrame, error) {
+	return g.aggregate(col, func(values []float64) (float64, bool) {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum, true
+	})
+}
+
+// Mean はグループごとに col の値の平均を求めた *DataFrame を返します。空セルは集計から除外します。
+// 全セルが空のグループは平均が定義できないため、結果セルを空文字列にします。
+func (g *GroupedFrame) Mean(col string) (*DataFrame, error) {
+	return g.aggregate(col, func(values []float64) (float64, bool) {
+		if len(values) == 0 {
+			return 0, false
+		}
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), true
+	})
+}
+
+// Min はグループごとに col の最小値を求めた *DataFrame を返します。空セルは集計から除外します。
+// 全セルが空のグループは最小値が定義できないため、結果セルを空文字列にします。
+func (g *GroupedFrame) Min(col string) (*DataFrame, error) {
+	return g.aggregate(col, func(values []float64) (float64, bool) {
+		if len(values) == 0 {
+			return 0, false
+		}
+		min := values[0]
+		for _, v := range values[1:] {
+			if v < min {
+				min = v
+			}
+		}
+		return min, true
+	})
+}
+
+// Max はグループごとに col の最大値を求めた *DataFrame を返します。空セルは集計から除外します。
+// 全セルが空のグループは最大値が定義できないため、結果セルを空文字列にします。
+func (g *GroupedFrame) Max(col string) (*DataFrame, error) {
+	return g.aggregate(col, func(values []float64) (float64, bool) {
+		if len(values) == 0 {
+			return 0, false
+		}
+		max := values[0]
+		for _, v := range values[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max, true
+	})
+}
+
+// aggregate は col の数値を GetFloat と同じパース規則で集め、reduce で1つの値にまとめます。
+// reduce が ok=false を返した場合 (集計対象の値が1つもない場合) は、結果セルを空文字列にします。
+func (g *GroupedFrame) aggregate(col string, reduce func(values []float64) (result float64, ok bool)) (*DataFrame, error) {
+	if _, exists := g.source.Headers[col]; !exists {
+		return nil, fmt.Errorf("column '%s' does not exist", col)
+	}
+
+	headerMap := g.resultHeaders(col)
+	records := make([][]string, 0, len(g.order))
+	for _, key := range g.order {
+		rows := g.groups[key]
+
+		var values []float64
+		for _, rowIdx := range rows {
+			raw, err := g.source.getValue(rowIdx, col)
+			if err != nil {
+				return nil, err
+			}
+			if raw == "" { // 空セルは集計から除外
+				continue
+			}
+			v, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse float in column '%s' at row %d: %w", col, rowIdx, err)
+			}
+			values = append(values, v)
+		}
+
+		cell := ""
+		if result, ok := reduce(values); ok {
+			cell = strconv.FormatFloat(result, 'f', -1, 64)
+		}
+
+		record := append(g.groupColValues(rows), cell)
+		records = append(records, record)
+	}
+
+	return &DataFrame{Headers: headerMap, Records: records, dateFormat: g.source.dateFormat, timeFormat: g.source.timeFormat}, nil
+}
@@ -0,0 +1,157 @@
+package csvloader
+
+import (
+	"fmt"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// csvConfig は OpenCSV / OpenCSVStream の挙動を調整するための設定値を保持します。
+type csvConfig struct {
+	encoding        string
+	decoder         transform.Transformer
+	delimiter       rune
+	comment         rune
+	lazyQuotes      bool
+	fieldsPerRecord *int
+	headerless      []string
+	dateFormat      string
+	timeFormat      string
+	bom             bool
+}
+
+// Option は OpenCSV / OpenCSVStream の読み込み方法を設定する関数型です。
+type Option func(*csvConfig)
+
+// WithEncoding は読み込み元CSVの文字エンコーディングを指定します (例: "utf-8", "shift-jis")。
+func WithEncoding(encoding string) Option {
+	return func(c *csvConfig) {
+		c.encoding = encoding
+	}
+}
+
+// WithDecoder は WithEncoding の代わりに任意の transform.Transformer をデコーダとして使用します。
+// EUC-JP / ISO-2022-JP 以外の文字コードなど、組み込みで対応していないエンコーディング向けの抜け道です。
+func WithDecoder(decoder transform.Transformer) Option {
+	return func(c *csvConfig) {
+		c.decoder = decoder
+	}
+}
+
+// WithDelimiter はフィールド区切り文字を指定します。TSV やパイプ区切りCSVの読み込みに使用します。
+func WithDelimiter(delimiter rune) Option {
+	return func(c *csvConfig) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithComment はコメント行の開始文字を指定します。指定した文字で始まる行は読み飛ばされます。
+func WithComment(comment rune) Option {
+	return func(c *csvConfig) {
+		c.comment = comment
+	}
+}
+
+// WithLazyQuotes は csv.Reader.LazyQuotes を有効にし、引用符の扱いを緩くします。
+func WithLazyQuotes(lazyQuotes bool) Option {
+	return func(c *csvConfig) {
+		c.lazyQuotes = lazyQuotes
+	}
+}
+
+// WithFieldsPerRecord は1行あたりのフィールド数の検証を設定します。
+// 0 を渡すと1行目のフィールド数に合わせ、負の値を渡すと検証を無効化します。
+func WithFieldsPerRecord(n int) Option {
+	return func(c *csvConfig) {
+		c.fieldsPerRecord = &n
+	}
+}
+
+// WithHeaderless はヘッダー行を持たないCSVを読み込むためのオプションです。
+// 指定された headers がそのままヘッダーとして使われ、1行目からデータ行として読み込まれます。
+func WithHeaderless(headers []string) Option {
+	return func(c *csvConfig) {
+		c.headerless = headers
+	}
+}
+
+// WithDateFormat は GetDate / GetDatePtr / Unmarshal が使用する日付フォーマットを上書きします。
+func WithDateFormat(format string) Option {
+	return func(c *csvConfig) {
+		c.dateFormat = format
+	}
+}
+
+// WithTimeFormat は GetTime / GetTimePtr / Unmarshal が使用する時刻フォーマットを上書きします。
+func WithTimeFormat(format string) Option {
+	return func(c *csvConfig) {
+		c.timeFormat = format
+	}
+}
+
+// WithBOM は WriteCSV でUTF-8出力する際にBOMを先頭に付与します。UTF-8 以外の
+// エンコーディングでは無視されます。MarshalCSV はファイルを書き出さないため、この設定を参照しません。
+func WithBOM(bom bool) Option {
+	return func(c *csvConfig) {
+		c.bom = bom
+	}
+}
+
+// buildConfig はデフォルト値に opts を適用した csvConfig を構築します。
+func buildConfig(opts ...Option) *csvConfig {
+	c := &csvConfig{
+		encoding:   "utf-8",
+		dateFormat: dateFormat,
+		timeFormat: timeFormat,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// resolveDecoder は設定済みの decoder を返すか、encoding から解決します。
+func (c *csvConfig) resolveDecoder() (transform.Transformer, error) {
+	if c.decoder != nil {
+		return c.decoder, nil
+	}
+	return newDecoder(c.encoding)
+}
+
+// resolveEncoder は書き込み時に使用する transform.Transformer を encoding から解決します。
+func (c *csvConfig) resolveEncoder() (transform.Transformer, error) {
+	return newEncoder(c.encoding)
+}
+
+// newDecoder はエンコーディング名から対応する transform.Transformer を返します。
+func newDecoder(encoding string) (transform.Transformer, error) {
+	switch encoding {
+	case "utf-8":
+		return unicode.BOMOverride(unicode.UTF8.NewDecoder()), nil // UTF-8 (BOM付き)対応
+	case "shift-jis", "shift_jis", "sjis":
+		return unicode.BOMOverride(japanese.ShiftJIS.NewDecoder()), nil // Shift-JIS対応
+	case "euc-jp", "eucjp":
+		return unicode.BOMOverride(japanese.EUCJP.NewDecoder()), nil // EUC-JP対応
+	case "iso-2022-jp", "iso2022jp":
+		return unicode.BOMOverride(japanese.ISO2022JP.NewDecoder()), nil // ISO-2022-JP対応
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+}
+
+// newEncoder はエンコーディング名から対応する書き込み用の transform.Transformer を返します。
+func newEncoder(encoding string) (transform.Transformer, error) {
+	switch encoding {
+	case "utf-8":
+		return unicode.UTF8.NewEncoder(), nil
+	case "shift-jis", "shift_jis", "sjis":
+		return japanese.ShiftJIS.NewEncoder(), nil
+	case "euc-jp", "eucjp":
+		return japanese.EUCJP.NewEncoder(), nil
+	case "iso-2022-jp", "iso2022jp":
+		return japanese.ISO2022JP.NewEncoder(), nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+}
@@ -0,0 +1,263 @@
+package csvloader
+
+import (
+	"encoding/csv"
+	"fmt"
+	"golang.org/x/text/transform"
+	"gorm.io/datatypes"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// RowIterator は巨大なCSVファイルを1行ずつ読み込むためのストリーミングリーダーです。
+// OpenCSV の reader.ReadAll() と違い、一度に1レコードしかメモリに保持しません。
+type RowIterator struct {
+	file    *os.File
+	reader  *csv.Reader
+	headers map[string]int
+	current []string
+	err     error
+
+	dateFormat string
+	timeFormat string
+}
+
+// OpenCSVStream はCSVファイルをストリーミングモードで開きます。OpenCSV と同じ Option を受け付けます。
+func OpenCSVStream(filePath string, opts ...Option) (*RowIterator, error) {
+	config := buildConfig(opts...)
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	decoder, err := config.resolveDecoder()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	reader := csv.NewReader(transform.NewReader(file, decoder))
+	applyDialect(reader, config)
+
+	var headers []string
+	if config.headerless != nil {
+		headers = config.headerless
+	} else {
+		headers, err = reader.Read()
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to read headers: %w", err)
+		}
+	}
+
+	headerMap := make(map[string]int)
+	for i, header := range headers {
+		headerMap[header] = i
+	}
+
+	return &RowIterator{
+		file:       file,
+		reader:     reader,
+		headers:    headerMap,
+		dateFormat: config.dateFormat,
+		timeFormat: config.timeFormat,
+	}, nil
+}
+
+// Next は次の行を読み込み、読み込めた場合は true を返します。
+// io.EOF に達した場合や読み込みエラーが発生した場合は false を返します。
+// エラーの詳細は Close の戻り値で確認できます。
+func (it *RowIterator) Next() bool {
+	record, err := it.reader.Read()
+	if err != nil {
+		if err != io.EOF {
+			it.err = fmt.Errorf("failed to read record: %w", err)
+		}
+		it.current = nil
+		return false
+	}
+	it.current = record
+	return true
+}
+
+// Row は現在の行を GetString/GetInt などで個別に参照するための *Row を返します。
+func (it *RowIterator) Row() *Row {
+	return &Row{
+		headers:    it.headers,
+		record:     it.current,
+		dateFormat: it.dateFormat,
+		timeFormat: it.timeFormat,
+	}
+}
+
+// Scan は現在の行を、構造体フィールドの `csv`/`label`/`format` タグにもとづいて out にマッピングします。
+func (it *RowIterator) Scan(out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("out must be a non-nil pointer to a struct")
+	}
+	return unmarshalRow(it.headers, it.current, it.dateFormat, it.timeFormat, v.Elem())
+}
+
+// Close は内部で開いているファイルを閉じます。Next が false を返すまでに読み込みエラーが
+// 発生していた場合は、そのエラーを返します。
+func (it *RowIterator) Close() error {
+	err := it.file.Close()
+	if it.err != nil {
+		return it.err
+	}
+	return err
+}
+
+// Row はストリーミング読み込み中の1行分のデータを保持し、DataFrame と同じ Get* 系の
+// アドホックなアクセスを提供します。
+type Row struct {
+	headers map[string]int
+	record  []string
+
+	dateFormat string
+	timeFormat string
+}
+
+func (r *Row) getValue(columnName string) (string, error) {
+	return getCell(r.headers, r.record, columnName)
+}
+
+// GetString は指定されたカラムの値を文字列として取得します。
+func (r *Row) GetString(columnName string) (string, error) {
+	return r.getValue(columnName)
+}
+
+// GetStringPtr は指定されたカラムの値をポインタ型の文字列として取得します。
+func (r *Row) GetStringPtr(columnName string) (*string, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+// GetInt は指定されたカラムの値を整数として取得します。
+func (r *Row) GetInt(columnName string) (int, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return 0, err
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int in column '%s': %w", columnName, err)
+	}
+	return intValue, nil
+}
+
+// GetIntPtr は指定されたカラムの値をポインタ型の整数として取得します。
+func (r *Row) GetIntPtr(columnName string) (*int, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse int in column '%s': %w", columnName, err)
+	}
+	return &intValue, nil
+}
+
+// GetFloat は指定されたカラムの値を浮動小数点数として取得します。
+func (r *Row) GetFloat(columnName string) (float64, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return 0.0, err
+	}
+	floatValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("failed to parse float in column '%s': %w", columnName, err)
+	}
+	return floatValue, nil
+}
+
+// GetFloatPtr は指定されたカラムの値をポインタ型の浮動小数点数として取得します。
+func (r *Row) GetFloatPtr(columnName string) (*float64, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	floatValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse float in column '%s': %w", columnName, err)
+	}
+	return &floatValue, nil
+}
+
+// GetDate は指定されたカラムの値を日付型として取得します。
+func (r *Row) GetDate(columnName string) (datatypes.Date, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return datatypes.Date{}, err
+	}
+	parsed, err := time.Parse(r.dateFormat, value)
+	if err != nil {
+		return datatypes.Date{}, fmt.Errorf("failed to parse date in column '%s': %w", columnName, err)
+	}
+	return datatypes.Date(parsed), nil
+}
+
+// GetDatePtr は指定されたカラムの値をポインタ型の日付として取得します。
+func (r *Row) GetDatePtr(columnName string) (*datatypes.Date, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(r.dateFormat, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse date in column '%s': %w", columnName, err)
+	}
+	t := datatypes.Date(parsed)
+	return &t, nil
+}
+
+// GetTime は指定されたカラムの値を時刻型として取得します。
+func (r *Row) GetTime(columnName string) (datatypes.Time, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return datatypes.NewTime(0, 0, 0, 0), err
+	}
+	parsed, err := time.Parse(r.timeFormat, value)
+	if err != nil {
+		return datatypes.NewTime(0, 0, 0, 0), fmt.Errorf("failed to parse time in column '%s': %w", columnName, err)
+	}
+	return datatypes.NewTime(parsed.Hour(), parsed.Minute(), parsed.Second(), 0), nil
+}
+
+// GetTimePtr は指定されたカラムの値をポインタ型の時刻として取得します。
+func (r *Row) GetTimePtr(columnName string) (*datatypes.Time, error) {
+	value, err := r.getValue(columnName)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(r.timeFormat, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time in column '%s': %w", columnName, err)
+	}
+	t := datatypes.NewTime(parsed.Hour(), parsed.Minute(), parsed.Second(), 0)
+	return &t, nil
+}
@@ -0,0 +1,198 @@
+package csvloader
+
+import (
+	"fmt"
+	"gorm.io/datatypes"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	datatypesDateType = reflect.TypeOf(datatypes.Date{})
+	datatypesTimeType = reflect.TypeOf(datatypes.Time(0))
+)
+
+// Unmarshal は指定された行のカラム値を、構造体フィールドの `csv`/`label`/`format` タグに
+// もとづいて out にマッピングします。out は構造体へのポインタである必要があります。
+func (df *DataFrame) Unmarshal(rowIndex int, out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("out must be a non-nil pointer to a struct")
+	}
+	return df.unmarshalRowAt(rowIndex, v.Elem())
+}
+
+// UnmarshalAll は全行を Unmarshal し、out が指すスライスに格納します。
+// out は構造体スライス (またはそのポインタのスライス) へのポインタである必要があります。
+func (df *DataFrame) UnmarshalAll(out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("out must be a non-nil pointer to a slice")
+	}
+
+	sliceVal := v.Elem()
+	elemType := sliceVal.Type().Elem()
+	elemIsPtr := elemType.Kind() == reflect.Ptr
+	if elemIsPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("out must be a pointer to a slice of structs")
+	}
+
+	result := reflect.MakeSlice(sliceVal.Type(), len(df.Records), len(df.Records))
+	for i := range df.Records {
+		elemPtr := reflect.New(elemType)
+		if err := df.unmarshalRowAt(i, elemPtr.Elem()); err != nil {
+			return err
+		}
+		if elemIsPtr {
+			result.Index(i).Set(elemPtr)
+		} else {
+			result.Index(i).Set(elemPtr.Elem())
+		}
+	}
+	sliceVal.Set(result)
+	return nil
+}
+
+// unmarshalRow は1行分のデータを structVal の各フィールドに設定します。
+// DataFrame.Unmarshal と RowIterator.Scan の両方から共有される基盤ロジックです。
+// dateFormat / timeFormat は `format` タグが指定されていない場合のデフォルト書式です。
+func unmarshalRow(headers map[string]int, record []string, dateFormat, timeFormat string, structVal reflect.Value) error {
+	t := structVal.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported フィールドはスキップ
+			continue
+		}
+
+		colName, ok := resolveColumn(headers, field)
+		if !ok {
+			continue
+		}
+
+		if err := setField(headers, record, colName, dateFormat, timeFormat, field, structVal.Field(i)); err != nil {
+			return fmt.Errorf("failed to unmarshal field '%s': %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+func (df *DataFrame) unmarshalRowAt(rowIndex int, structVal reflect.Value) error {
+	if rowIndex < 0 || rowIndex >= len(df.Records) {
+		return fmt.Errorf("row index %d out of range", rowIndex)
+	}
+	return unmarshalRow(df.Headers, df.Records[rowIndex], df.dateFormat, df.timeFormat, structVal)
+}
+
+// resolveColumn はフィールドに対応するヘッダー名を `csv` タグ、`label` タグ、フィールド名の順で解決します。
+func resolveColumn(headers map[string]int, field reflect.StructField) (string, bool) {
+	if tag, ok := field.Tag.Lookup("csv"); ok {
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			return "", false
+		}
+		if _, exists := headers[name]; exists {
+			return name, true
+		}
+	}
+	if label, ok := field.Tag.Lookup("label"); ok {
+		if _, exists := headers[label]; exists {
+			return label, true
+		}
+	}
+	if _, exists := headers[field.Name]; exists {
+		return field.Name, true
+	}
+	return "", false
+}
+
+// setField は1つのフィールドにカラム値をパースして設定します。`format` タグは
+// time.Time / datatypes.Date / datatypes.Time のパース書式を上書きします。
+func setField(headers map[string]int, record []string, colName, defaultDateFormat, defaultTimeFormat string, field reflect.StructField, fieldVal reflect.Value) error {
+	raw, err := getCell(headers, record, colName)
+	if err != nil {
+		return err
+	}
+
+	isPtr := fieldVal.Kind() == reflect.Ptr
+	targetType := fieldVal.Type()
+	if isPtr {
+		targetType = targetType.Elem()
+	}
+
+	if isPtr && raw == "" {
+		fieldVal.Set(reflect.Zero(fieldVal.Type()))
+		return nil
+	}
+
+	format := field.Tag.Get("format")
+
+	var parsed reflect.Value
+	switch {
+	case targetType == timeType:
+		if format == "" {
+			format = time.RFC3339
+		}
+		t, err := time.Parse(format, raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse time.Time in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(t)
+	case targetType == datatypesDateType:
+		f := format
+		if f == "" {
+			f = defaultDateFormat
+		}
+		t, err := time.Parse(f, raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse datatypes.Date in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(datatypes.Date(t))
+	case targetType == datatypesTimeType:
+		f := format
+		if f == "" {
+			f = defaultTimeFormat
+		}
+		t, err := time.Parse(f, raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse datatypes.Time in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(datatypes.NewTime(t.Hour(), t.Minute(), t.Second(), 0))
+	case targetType.Kind() == reflect.String:
+		parsed = reflect.ValueOf(raw)
+	case targetType.Kind() == reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse int in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(n)
+	case targetType.Kind() == reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse float64 in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(n)
+	case targetType.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse bool in column '%s': %w", colName, err)
+		}
+		parsed = reflect.ValueOf(b)
+	default:
+		return fmt.Errorf("unsupported field type %s for column '%s'", targetType, colName)
+	}
+
+	if isPtr {
+		ptr := reflect.New(targetType)
+		ptr.Elem().Set(parsed)
+		fieldVal.Set(ptr)
+	} else {
+		fieldVal.Set(parsed)
+	}
+	return nil
+}
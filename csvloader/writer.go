@@ -0,0 +1,187 @@
+package csvloader
+
+import (
+	"encoding/csv"
+	"fmt"
+	"golang.org/x/text/transform"
+	"gorm.io/datatypes"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// utf8BOM はUTF-8のバイトオーダーマークです。
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// WriteCSV は DataFrame の内容をCSVファイルとして書き出します。OpenCSV と同じエンコーディング表を
+// 使用して UTF-8 (BOMオプション付き) や Shift-JIS に再エンコードします。
+func (df *DataFrame) WriteCSV(path string, opts ...Option) error {
+	config := buildConfig(opts...)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	encoder, err := config.resolveEncoder()
+	if err != nil {
+		return err
+	}
+
+	if config.bom && config.encoding == "utf-8" {
+		if _, err := file.Write(utf8BOM); err != nil {
+			return fmt.Errorf("failed to write BOM: %w", err)
+		}
+	}
+
+	writer := csv.NewWriter(transform.NewWriter(file, encoder))
+	if config.delimiter != 0 {
+		writer.Comma = config.delimiter
+	}
+
+	headers := make([]string, len(df.Headers))
+	for name, idx := range df.Headers {
+		headers[idx] = name
+	}
+
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write headers: %w", err)
+	}
+	if err := writer.WriteAll(df.Records); err != nil {
+		return fmt.Errorf("failed to write records: %w", err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush records: %w", err)
+	}
+	return nil
+}
+
+// MarshalCSV は `csv`/`label`/`format` タグを持つ構造体のスライスから *DataFrame を構築します。
+// Unmarshal/UnmarshalAll と対になる書き込み側のエントリポイントです。
+func MarshalCSV(slice interface{}, opts ...Option) (*DataFrame, error) {
+	config := buildConfig(opts...)
+
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("slice must be a slice of structs")
+	}
+
+	elemType := v.Type().Elem()
+	elemIsPtr := elemType.Kind() == reflect.Ptr
+	if elemIsPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("slice must be a slice of structs")
+	}
+
+	var fields []reflect.StructField
+	headerMap := make(map[string]int)
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		if field.PkgPath != "" { // unexported フィールドはスキップ
+			continue
+		}
+		name, ok := columnNameFor(field)
+		if !ok {
+			continue
+		}
+		headerMap[name] = len(fields)
+		fields = append(fields, field)
+	}
+
+	records := make([][]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		if elemIsPtr {
+			elem = elem.Elem()
+		}
+
+		record := make([]string, len(fields))
+		for j, field := range fields {
+			cell, err := formatField(field, elem.FieldByIndex(field.Index), config.dateFormat, config.timeFormat)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal field '%s': %w", field.Name, err)
+			}
+			record[j] = cell
+		}
+		records[i] = record
+	}
+
+	return &DataFrame{
+		Headers:    headerMap,
+		Records:    records,
+		dateFormat: config.dateFormat,
+		timeFormat: config.timeFormat,
+	}, nil
+}
+
+// columnNameFor は MarshalCSV がヘッダーとして使うカラム名を `csv` タグ、`label` タグ、
+// フィールド名の順で解決します。
+func columnNameFor(field reflect.StructField) (string, bool) {
+	if tag, ok := field.Tag.Lookup("csv"); ok {
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			return "", false
+		}
+		return name, true
+	}
+	if label, ok := field.Tag.Lookup("label"); ok {
+		return label, true
+	}
+	return field.Name, true
+}
+
+// formatField は1つのフィールドをCSVセルの文字列表現に変換します。ポインタが nil の場合は
+// 空文字列を返します。`format` タグは time.Time / datatypes.Date / datatypes.Time の書式を上書きします。
+func formatField(field reflect.StructField, fieldVal reflect.Value, defaultDateFormat, defaultTimeFormat string) (string, error) {
+	if fieldVal.Kind() == reflect.Ptr {
+		if fieldVal.IsNil() {
+			return "", nil
+		}
+		fieldVal = fieldVal.Elem()
+	}
+
+	format := field.Tag.Get("format")
+
+	switch fieldVal.Type() {
+	case timeType:
+		f := format
+		if f == "" {
+			f = time.RFC3339
+		}
+		return fieldVal.Interface().(time.Time).Format(f), nil
+	case datatypesDateType:
+		f := format
+		if f == "" {
+			f = defaultDateFormat
+		}
+		t := time.Time(fieldVal.Interface().(datatypes.Date))
+		return t.Format(f), nil
+	case datatypesTimeType:
+		f := format
+		if f == "" {
+			f = defaultTimeFormat
+		}
+		d := time.Duration(fieldVal.Interface().(datatypes.Time))
+		t := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d)
+		return t.Format(f), nil
+	}
+
+	switch fieldVal.Kind() {
+	case reflect.String:
+		return fieldVal.String(), nil
+	case reflect.Int:
+		return fmt.Sprintf("%d", fieldVal.Int()), nil
+	case reflect.Float64:
+		return fmt.Sprintf("%g", fieldVal.Float()), nil
+	case reflect.Bool:
+		return fmt.Sprintf("%t", fieldVal.Bool()), nil
+	default:
+		return "", fmt.Errorf("unsupported field type %s", fieldVal.Type())
+	}
+}
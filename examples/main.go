@@ -19,7 +19,7 @@ func main() {
 	defer file.Close()
 
 	// CSVをパース
-	data, err := csvloader.OpenCSV(csvFile, "utf-8")
+	data, err := csvloader.OpenCSV(csvFile, csvloader.WithEncoding("utf-8"))
 	if err != nil {
 		log.Fatalf("failed to load CSV: %v", err)
 	}